@@ -0,0 +1,158 @@
+package reddit
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SearchQuery composes Reddit's advanced search operators (subreddit:,
+// author:, self:, nsfw:, flair:, url:, title:, and negation) into a single,
+// properly-escaped query string. Build it with Query() and pass the result
+// to SearchService.Posts via SetQueryBuilder.
+type SearchQuery struct {
+	terms     string
+	fragments []string
+}
+
+// Query starts a new SearchQuery.
+func Query() *SearchQuery {
+	return &SearchQuery{}
+}
+
+// Terms sets the free-text portion of the query. Unlike field values,
+// plain spaces aren't quoted, since Reddit treats space-separated terms
+// as independent keywords; only characters that could be misread as an
+// operator are escaped.
+func (q *SearchQuery) Terms(v string) *SearchQuery {
+	if strings.ContainsAny(v, ":\"") {
+		v = strconv.Quote(v)
+	}
+	q.terms = v
+	return q
+}
+
+// Subreddit restricts results to the given subreddit.
+func (q *SearchQuery) Subreddit(v string) *SearchQuery {
+	return q.field("subreddit", v)
+}
+
+// Author restricts results to the given author.
+func (q *SearchQuery) Author(v string) *SearchQuery {
+	return q.field("author", v)
+}
+
+// Title restricts results to posts whose title contains v.
+func (q *SearchQuery) Title(v string) *SearchQuery {
+	return q.field("title", v)
+}
+
+// URL restricts results to posts linking to v.
+func (q *SearchQuery) URL(v string) *SearchQuery {
+	return q.field("url", v)
+}
+
+// Flair restricts results to the given post flair. The value is always
+// quoted, since flair text commonly contains spaces.
+func (q *SearchQuery) Flair(v string) *SearchQuery {
+	q.fragments = append(q.fragments, "flair:"+strconv.Quote(v))
+	return q
+}
+
+// Self restricts results to (or excludes) self posts.
+func (q *SearchQuery) Self(v bool) *SearchQuery {
+	return q.field("self", yesNo(v))
+}
+
+// NSFW restricts results to (or excludes) posts marked NSFW.
+func (q *SearchQuery) NSFW(v bool) *SearchQuery {
+	return q.field("nsfw", yesNo(v))
+}
+
+// Not negates inner, prefixing each of its terms and fragments with "-" so
+// they exclude rather than restrict matches. Everything is negated
+// individually, so a multi-word, multi-operator inner query like
+// Query().Terms("golang jobs").Subreddit("a") builds
+// "-golang -jobs -subreddit:a" rather than negating only the first word or
+// the first operator.
+func (q *SearchQuery) Not(inner *SearchQuery) *SearchQuery {
+	for _, term := range splitQueryTerms(inner.terms) {
+		q.fragments = append(q.fragments, "-"+term)
+	}
+	for _, fragment := range inner.fragments {
+		q.fragments = append(q.fragments, "-"+fragment)
+	}
+	return q
+}
+
+// splitQueryTerms splits s on whitespace into individual words, keeping a
+// double-quoted substring (as produced by strconv.Quote in Terms) together
+// as a single word instead of splitting on the spaces inside it.
+func splitQueryTerms(s string) []string {
+	var terms []string
+	var term strings.Builder
+	inQuotes := false
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			term.WriteRune(r)
+		case r == ' ' && !inQuotes:
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+		default:
+			term.WriteRune(r)
+		}
+	}
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+
+	return terms
+}
+
+// Build returns the query string this SearchQuery composes.
+func (q *SearchQuery) Build() string {
+	parts := make([]string, 0, len(q.fragments)+1)
+	if q.terms != "" {
+		parts = append(parts, q.terms)
+	}
+	parts = append(parts, q.fragments...)
+	return strings.Join(parts, " ")
+}
+
+// field appends a "name:value" fragment, quoting value if it contains
+// spaces or characters that are otherwise significant to Reddit's search
+// syntax.
+func (q *SearchQuery) field(name, v string) *SearchQuery {
+	q.fragments = append(q.fragments, name+":"+quoteSearchValue(v))
+	return q
+}
+
+// quoteSearchValue quotes v if it contains whitespace or characters that
+// are otherwise significant to Reddit's search syntax.
+func quoteSearchValue(v string) string {
+	if strings.ContainsAny(v, " \t:()\"") {
+		return strconv.Quote(v)
+	}
+	return v
+}
+
+func yesNo(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
+
+// SetQueryBuilder sets the q option from a SearchQuery, escaping Reddit's
+// advanced search operators instead of requiring callers to hand-concatenate
+// them.
+func SetQueryBuilder(q *SearchQuery) SearchOptionSetter {
+	return func(opts url.Values) {
+		opts.Set("q", q.Build())
+	}
+}
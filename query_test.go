@@ -0,0 +1,42 @@
+package reddit
+
+import "testing"
+
+func TestSearchQueryBuild(t *testing.T) {
+	tests := []struct {
+		name string
+		q    *SearchQuery
+		want string
+	}{
+		{
+			name: "fields and a single-operator negation",
+			q: Query().Terms("golang concurrency").Subreddit("golang").Author("spf13").
+				Self(true).NSFW(false).Flair("help").URL("github.com").
+				Not(Query().Title("meta")),
+			want: `golang concurrency subreddit:golang author:spf13 self:yes nsfw:no flair:"help" url:github.com -title:meta`,
+		},
+		{
+			name: "values with spaces are quoted",
+			q:    Query().Subreddit("golang").Author("some user"),
+			want: `subreddit:golang author:"some user"`,
+		},
+		{
+			name: "negating a multi-operator query negates every fragment",
+			q:    Query().Terms("golang").Not(Query().Subreddit("a").Flair("b")),
+			want: `golang -subreddit:a -flair:"b"`,
+		},
+		{
+			name: "negating multi-word terms negates every word",
+			q:    Query().Not(Query().Terms("golang jobs")),
+			want: `-golang -jobs`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.Build(); got != tt.want {
+				t.Fatalf("Build() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
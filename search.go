@@ -135,9 +135,16 @@ func setType(v string) SearchOptionSetter {
 	}
 }
 
-// setQuery sets the q option.
+// setQuery sets the q option. It leaves an existing q untouched when v is
+// empty, so that a query already set by SetQueryBuilder isn't clobbered by
+// the literal (empty) query string a caller passes to Posts/Subreddits/Users
+// alongside it. Callers who pass an empty query and no SetQueryBuilder still
+// get q="", matching the pre-existing behavior.
 func setQuery(v string) SearchOptionSetter {
 	return func(opts url.Values) {
+		if v == "" && opts.Has("q") {
+			return
+		}
 		opts.Set("q", v)
 	}
 }
@@ -147,9 +154,18 @@ func setRestrict(opts url.Values) {
 	opts.Set("restrict_sr", "true")
 }
 
-// Posts searches for posts.
-// If the list of subreddits provided is empty, the search is run against r/all.
-func (s *SearchService) Posts(ctx context.Context, query string, subreddits []string, opts ...SearchOptionSetter) (*Posts, *Response, error) {
+// SearchTypes sets the type option to the given comma-joined kinds, e.g.
+// "link", "sr", or "user". It's intended for use with SearchService.All,
+// which otherwise searches all three kinds at once.
+func SearchTypes(kinds ...string) SearchOptionSetter {
+	return func(opts url.Values) {
+		opts.Set("type", strings.Join(kinds, ","))
+	}
+}
+
+// postsListing issues the underlying request shared by Posts and the
+// deprecated PostsList.
+func (s *SearchService) postsListing(ctx context.Context, query string, subreddits []string, opts ...SearchOptionSetter) (*rootListing, *Response, error) {
 	opts = append(opts, setType("link"), setQuery(query))
 
 	path := "search"
@@ -171,18 +187,42 @@ func (s *SearchService) Posts(ctx context.Context, query string, subreddits []st
 	if err != nil {
 		return nil, resp, err
 	}
+	root.populatePagination(resp)
+
+	return root, resp, nil
+}
 
+// Posts searches for posts.
+// If the list of subreddits provided is empty, the search is run against r/all.
+func (s *SearchService) Posts(ctx context.Context, query string, subreddits []string, opts ...SearchOptionSetter) ([]*Post, *Response, error) {
+	root, resp, err := s.postsListing(ctx, query, subreddits, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getPosts().Posts, resp, nil
+}
+
+// PostsList searches for posts, returning them wrapped in a *Posts instead
+// of as a plain slice.
+//
+// Deprecated: use Posts, which returns a []*Post directly and carries the
+// pagination cursor on the returned *Response. PostsList is kept for the
+// v1.x series and will be removed in v2.
+func (s *SearchService) PostsList(ctx context.Context, query string, subreddits []string, opts ...SearchOptionSetter) (*Posts, *Response, error) {
+	root, resp, err := s.postsListing(ctx, query, subreddits, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
 	return root.getPosts(), resp, nil
 }
 
-// Subreddits searches for subreddits.
-// The sort and timespan options don't affect the results for this search.
-func (s *SearchService) Subreddits(ctx context.Context, query string, opts ...SearchOptionSetter) (*Subreddits, *Response, error) {
+// subredditsListing issues the underlying request shared by Subreddits and
+// the deprecated SubredditsList.
+func (s *SearchService) subredditsListing(ctx context.Context, query string, opts ...SearchOptionSetter) (*rootListing, *Response, error) {
 	opts = append(opts, setType("sr"), setQuery(query))
 	form := newSearchOptions(opts...)
 
-	path := "search"
-	path = addQuery(path, form)
+	path := addQuery("search", form)
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
@@ -194,18 +234,42 @@ func (s *SearchService) Subreddits(ctx context.Context, query string, opts ...Se
 	if err != nil {
 		return nil, resp, err
 	}
+	root.populatePagination(resp)
 
-	return root.getSubreddits(), resp, nil
+	return root, resp, nil
 }
 
-// Users searches for users.
+// Subreddits searches for subreddits.
 // The sort and timespan options don't affect the results for this search.
-func (s *SearchService) Users(ctx context.Context, query string, opts ...SearchOptionSetter) (*Users, *Response, error) {
+func (s *SearchService) Subreddits(ctx context.Context, query string, opts ...SearchOptionSetter) ([]*Subreddit, *Response, error) {
+	root, resp, err := s.subredditsListing(ctx, query, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getSubreddits().Subreddits, resp, nil
+}
+
+// SubredditsList searches for subreddits, returning them wrapped in a
+// *Subreddits instead of as a plain slice.
+//
+// Deprecated: use Subreddits, which returns a []*Subreddit directly and
+// carries the pagination cursor on the returned *Response. SubredditsList is
+// kept for the v1.x series and will be removed in v2.
+func (s *SearchService) SubredditsList(ctx context.Context, query string, opts ...SearchOptionSetter) (*Subreddits, *Response, error) {
+	root, resp, err := s.subredditsListing(ctx, query, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getSubreddits(), resp, nil
+}
+
+// usersListing issues the underlying request shared by Users and the
+// deprecated UsersList.
+func (s *SearchService) usersListing(ctx context.Context, query string, opts ...SearchOptionSetter) (*rootListing, *Response, error) {
 	opts = append(opts, setType("user"), setQuery(query))
 	form := newSearchOptions(opts...)
 
-	path := "search"
-	path = addQuery(path, form)
+	path := addQuery("search", form)
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
@@ -217,6 +281,275 @@ func (s *SearchService) Users(ctx context.Context, query string, opts ...SearchO
 	if err != nil {
 		return nil, resp, err
 	}
+	root.populatePagination(resp)
+
+	return root, resp, nil
+}
 
+// Users searches for users.
+// The sort and timespan options don't affect the results for this search.
+func (s *SearchService) Users(ctx context.Context, query string, opts ...SearchOptionSetter) ([]*User, *Response, error) {
+	root, resp, err := s.usersListing(ctx, query, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.getUsers().Users, resp, nil
+}
+
+// UsersList searches for users, returning them wrapped in a *Users instead
+// of as a plain slice.
+//
+// Deprecated: use Users, which returns a []*User directly and carries the
+// pagination cursor on the returned *Response. UsersList is kept for the
+// v1.x series and will be removed in v2.
+func (s *SearchService) UsersList(ctx context.Context, query string, opts ...SearchOptionSetter) (*Users, *Response, error) {
+	root, resp, err := s.usersListing(ctx, query, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
 	return root.getUsers(), resp, nil
 }
+
+// populatePagination copies the After, Before, and Dist fields off the
+// listing envelope onto resp, so callers can page through results via the
+// response instead of having to pluck a cursor off an individual result.
+func (root *rootListing) populatePagination(resp *Response) {
+	if root.Data == nil || resp == nil {
+		return
+	}
+	populateResponsePagination(resp, root.Data.After, root.Data.Before, root.Data.Dist)
+}
+
+// populateResponsePagination copies after, before, and count onto resp
+// wherever they're non-nil. It's split out of populatePagination so the
+// copying logic can be unit tested without a real rootListing.
+func populateResponsePagination(resp *Response, after, before *string, count *int) {
+	if after != nil {
+		resp.After = *after
+	}
+	if before != nil {
+		resp.Before = *before
+	}
+	if count != nil {
+		resp.Count = *count
+	}
+}
+
+// SearchResults holds the results of a call to SearchService.All, which
+// can return a mix of posts, subreddits, and users in a single listing.
+type SearchResults struct {
+	Posts      []*Post
+	Subreddits []*Subreddit
+	Users      []*User
+}
+
+// allSearchForm builds the url.Values for All, defaulting type to all three
+// kinds and letting a caller-supplied SearchTypes in opts override it. It's
+// split out of All so the option-assembly can be unit tested without a real
+// Client.
+func allSearchForm(query string, opts ...SearchOptionSetter) url.Values {
+	opts = append([]SearchOptionSetter{SearchTypes("link", "sr", "user")}, opts...)
+	opts = append(opts, setQuery(query))
+	return newSearchOptions(opts...)
+}
+
+// All searches for posts, subreddits, and users matching query in a single
+// request, saving the three round trips that calling Posts, Subreddits, and
+// Users separately would take. Use SearchTypes to search a subset of kinds.
+func (s *SearchService) All(ctx context.Context, query string, opts ...SearchOptionSetter) (*SearchResults, *Response, error) {
+	form := allSearchForm(query, opts...)
+	path := addQuery("search", form)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	root.populatePagination(resp)
+
+	return &SearchResults{
+		Posts:      root.getPosts().Posts,
+		Subreddits: root.getSubreddits().Subreddits,
+		Users:      root.getUsers().Users,
+	}, resp, nil
+}
+
+// Sort is the order in which search results are returned.
+type Sort string
+
+// Sort options for searches.
+const (
+	SortHot           Sort = "hot"
+	SortBest          Sort = "best"
+	SortNew           Sort = "new"
+	SortRising        Sort = "rising"
+	SortControversial Sort = "controversial"
+	SortTop           Sort = "top"
+	SortRelevance     Sort = "relevance"
+	SortComments      Sort = "comments"
+)
+
+// Timespan restricts search results to a window of time.
+type Timespan string
+
+// Timespan options for searches.
+const (
+	TimespanHour  Timespan = "hour"
+	TimespanDay   Timespan = "day"
+	TimespanWeek  Timespan = "week"
+	TimespanMonth Timespan = "month"
+	TimespanYear  Timespan = "year"
+	TimespanAll   Timespan = "all"
+)
+
+// sortSetter returns a SearchOptionSetter that sets the sort option to the
+// given Sort.
+func sortSetter(sort Sort) SearchOptionSetter {
+	return func(opts url.Values) {
+		opts.Set("sort", string(sort))
+	}
+}
+
+// timespanSetter returns a SearchOptionSetter that sets the timespan option
+// to the given Timespan.
+func timespanSetter(timespan Timespan) SearchOptionSetter {
+	return func(opts url.Values) {
+		opts.Set("t", string(timespan))
+	}
+}
+
+// PostSearchBuilder builds a post search via a chainable API and runs it
+// via Do. It's an alternative to passing a sprawl of SearchOptionSetter
+// values to SearchService.Posts.
+type PostSearchBuilder struct {
+	service    *SearchService
+	query      string
+	subreddits []string
+	opts       []SearchOptionSetter
+}
+
+// NewPostQuery starts a new post search for the given query.
+func (s *SearchService) NewPostQuery(query string) *PostSearchBuilder {
+	return &PostSearchBuilder{service: s, query: query}
+}
+
+// FromSubreddits restricts the search to the given subreddits.
+// If none are provided, the search runs against r/all.
+func (b *PostSearchBuilder) FromSubreddits(subreddits ...string) *PostSearchBuilder {
+	b.subreddits = append(b.subreddits, subreddits...)
+	return b
+}
+
+// Sort sets the order in which results are returned.
+func (b *PostSearchBuilder) Sort(sort Sort) *PostSearchBuilder {
+	b.opts = append(b.opts, sortSetter(sort))
+	return b
+}
+
+// Timespan restricts the results to the given window of time.
+func (b *PostSearchBuilder) Timespan(timespan Timespan) *PostSearchBuilder {
+	b.opts = append(b.opts, timespanSetter(timespan))
+	return b
+}
+
+// Limit sets the maximum number of results to return.
+func (b *PostSearchBuilder) Limit(limit int) *PostSearchBuilder {
+	b.opts = append(b.opts, SetLimit(limit))
+	return b
+}
+
+// After sets the after cursor, continuing from a previous page of results.
+func (b *PostSearchBuilder) After(after string) *PostSearchBuilder {
+	b.opts = append(b.opts, SetAfter(after))
+	return b
+}
+
+// Before sets the before cursor, continuing from a previous page of results.
+func (b *PostSearchBuilder) Before(before string) *PostSearchBuilder {
+	b.opts = append(b.opts, SetBefore(before))
+	return b
+}
+
+// Do runs the search and returns the matching posts.
+func (b *PostSearchBuilder) Do(ctx context.Context) ([]*Post, *Response, error) {
+	return b.service.Posts(ctx, b.query, b.subreddits, b.opts...)
+}
+
+// SubredditSearchBuilder builds a subreddit search via a chainable API and
+// runs it via Do. It's an alternative to passing a sprawl of
+// SearchOptionSetter values to SearchService.Subreddits.
+type SubredditSearchBuilder struct {
+	service *SearchService
+	query   string
+	opts    []SearchOptionSetter
+}
+
+// NewSubredditQuery starts a new subreddit search for the given query.
+func (s *SearchService) NewSubredditQuery(query string) *SubredditSearchBuilder {
+	return &SubredditSearchBuilder{service: s, query: query}
+}
+
+// Limit sets the maximum number of results to return.
+func (b *SubredditSearchBuilder) Limit(limit int) *SubredditSearchBuilder {
+	b.opts = append(b.opts, SetLimit(limit))
+	return b
+}
+
+// After sets the after cursor, continuing from a previous page of results.
+func (b *SubredditSearchBuilder) After(after string) *SubredditSearchBuilder {
+	b.opts = append(b.opts, SetAfter(after))
+	return b
+}
+
+// Before sets the before cursor, continuing from a previous page of results.
+func (b *SubredditSearchBuilder) Before(before string) *SubredditSearchBuilder {
+	b.opts = append(b.opts, SetBefore(before))
+	return b
+}
+
+// Do runs the search and returns the matching subreddits.
+func (b *SubredditSearchBuilder) Do(ctx context.Context) ([]*Subreddit, *Response, error) {
+	return b.service.Subreddits(ctx, b.query, b.opts...)
+}
+
+// UserSearchBuilder builds a user search via a chainable API and runs it
+// via Do. It's an alternative to passing a sprawl of SearchOptionSetter
+// values to SearchService.Users.
+type UserSearchBuilder struct {
+	service *SearchService
+	query   string
+	opts    []SearchOptionSetter
+}
+
+// NewUserQuery starts a new user search for the given query.
+func (s *SearchService) NewUserQuery(query string) *UserSearchBuilder {
+	return &UserSearchBuilder{service: s, query: query}
+}
+
+// Limit sets the maximum number of results to return.
+func (b *UserSearchBuilder) Limit(limit int) *UserSearchBuilder {
+	b.opts = append(b.opts, SetLimit(limit))
+	return b
+}
+
+// After sets the after cursor, continuing from a previous page of results.
+func (b *UserSearchBuilder) After(after string) *UserSearchBuilder {
+	b.opts = append(b.opts, SetAfter(after))
+	return b
+}
+
+// Before sets the before cursor, continuing from a previous page of results.
+func (b *UserSearchBuilder) Before(before string) *UserSearchBuilder {
+	b.opts = append(b.opts, SetBefore(before))
+	return b
+}
+
+// Do runs the search and returns the matching users.
+func (b *UserSearchBuilder) Do(ctx context.Context) ([]*User, *Response, error) {
+	return b.service.Users(ctx, b.query, b.opts...)
+}
@@ -0,0 +1,48 @@
+package reddit
+
+import "testing"
+
+// TestAllSearchFormDefaultsToAllKinds verifies that All searches all three
+// kinds by default, and that a caller-supplied SearchTypes narrows it.
+func TestAllSearchFormDefaultsToAllKinds(t *testing.T) {
+	t.Run("defaults to link,sr,user", func(t *testing.T) {
+		form := allSearchForm("golang")
+		if got := form.Get("type"); got != "link,sr,user" {
+			t.Fatalf("type = %q, want %q", got, "link,sr,user")
+		}
+	})
+
+	t.Run("caller-supplied SearchTypes narrows the default", func(t *testing.T) {
+		form := allSearchForm("golang", SearchTypes("link"))
+		if got := form.Get("type"); got != "link" {
+			t.Fatalf("type = %q, want %q", got, "link")
+		}
+	})
+
+	t.Run("query is still set alongside type", func(t *testing.T) {
+		form := allSearchForm("golang")
+		if got := form.Get("q"); got != "golang" {
+			t.Fatalf("q = %q, want %q", got, "golang")
+		}
+	})
+}
+
+// TestSearchResultsAssembly verifies that SearchResults keeps each kind in
+// its own typed slice.
+func TestSearchResultsAssembly(t *testing.T) {
+	results := &SearchResults{
+		Posts:      []*Post{{Title: "a post"}},
+		Subreddits: []*Subreddit{{DisplayName: "golang"}},
+		Users:      []*User{{Name: "spf13"}},
+	}
+
+	if len(results.Posts) != 1 || results.Posts[0].Title != "a post" {
+		t.Fatalf("Posts = %+v, want one post titled %q", results.Posts, "a post")
+	}
+	if len(results.Subreddits) != 1 || results.Subreddits[0].DisplayName != "golang" {
+		t.Fatalf("Subreddits = %+v, want one subreddit named %q", results.Subreddits, "golang")
+	}
+	if len(results.Users) != 1 || results.Users[0].Name != "spf13" {
+		t.Fatalf("Users = %+v, want one user named %q", results.Users, "spf13")
+	}
+}
@@ -0,0 +1,75 @@
+package reddit
+
+import "testing"
+
+// TestPostSearchBuilder verifies that chaining PostSearchBuilder methods
+// accumulates the subreddits and options NewPostQuery's Do ultimately passes
+// to SearchService.Posts.
+func TestPostSearchBuilder(t *testing.T) {
+	s := &SearchService{}
+	b := s.NewPostQuery("golang").
+		FromSubreddits("golang", "programming").
+		Sort(SortTop).
+		Timespan(TimespanYear).
+		Limit(50).
+		After("t3_xxx")
+
+	if b.query != "golang" {
+		t.Fatalf("query = %q, want %q", b.query, "golang")
+	}
+	if got, want := b.subreddits, []string{"golang", "programming"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("subreddits = %v, want %v", got, want)
+	}
+
+	form := newSearchOptions(b.opts...)
+	if got := form.Get("sort"); got != string(SortTop) {
+		t.Fatalf("sort = %q, want %q", got, SortTop)
+	}
+	if got := form.Get("t"); got != string(TimespanYear) {
+		t.Fatalf("t = %q, want %q", got, TimespanYear)
+	}
+	if got := form.Get("limit"); got != "50" {
+		t.Fatalf("limit = %q, want %q", got, "50")
+	}
+	if got := form.Get("after"); got != "t3_xxx" {
+		t.Fatalf("after = %q, want %q", got, "t3_xxx")
+	}
+}
+
+// TestSubredditSearchBuilder verifies SubredditSearchBuilder's chainable
+// options, which omit Sort/Timespan/FromSubreddits since they don't apply to
+// subreddit searches.
+func TestSubredditSearchBuilder(t *testing.T) {
+	s := &SearchService{}
+	b := s.NewSubredditQuery("golang").Limit(10).Before("t5_xxx")
+
+	if b.query != "golang" {
+		t.Fatalf("query = %q, want %q", b.query, "golang")
+	}
+
+	form := newSearchOptions(b.opts...)
+	if got := form.Get("limit"); got != "10" {
+		t.Fatalf("limit = %q, want %q", got, "10")
+	}
+	if got := form.Get("before"); got != "t5_xxx" {
+		t.Fatalf("before = %q, want %q", got, "t5_xxx")
+	}
+}
+
+// TestUserSearchBuilder verifies UserSearchBuilder's chainable options.
+func TestUserSearchBuilder(t *testing.T) {
+	s := &SearchService{}
+	b := s.NewUserQuery("spf13").Limit(5).After("t2_xxx")
+
+	if b.query != "spf13" {
+		t.Fatalf("query = %q, want %q", b.query, "spf13")
+	}
+
+	form := newSearchOptions(b.opts...)
+	if got := form.Get("limit"); got != "5" {
+		t.Fatalf("limit = %q, want %q", got, "5")
+	}
+	if got := form.Get("after"); got != "t2_xxx" {
+		t.Fatalf("after = %q, want %q", got, "t2_xxx")
+	}
+}
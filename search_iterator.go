@@ -0,0 +1,363 @@
+package reddit
+
+import "context"
+
+// defaultIteratorPageSize is the page size requested for each underlying
+// search call made by an iterator, unless the caller overrides it via
+// SetLimit.
+const defaultIteratorPageSize = 100
+
+// PostIterator walks the pages of a post search, fetching a new page via
+// SearchService.Posts whenever the current one is exhausted. It removes the
+// need for callers to hand-roll an after-cursor loop themselves.
+//
+// Use it like:
+//
+//	it := client.Search.PostsIterator(ctx, "golang", nil, 0)
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Post().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle err
+//	}
+type PostIterator struct {
+	service    *SearchService
+	query      string
+	subreddits []string
+	opts       []SearchOptionSetter
+	maxCount   int
+
+	ctx    context.Context
+	stop   context.CancelFunc
+	resp   *Response
+	buf    []*Post
+	cur    *Post
+	after  string
+	count  int
+	noMore bool
+	done   bool
+	err    error
+
+	// fetchPage is overridden in tests to exercise the pagination
+	// bookkeeping in Next/fetch without a real SearchService.
+	fetchPage func() ([]*Post, *Response, error)
+}
+
+// PostsIterator returns a PostIterator over the given search. maxCount caps
+// the total number of posts returned across all pages; a value <= 0 means
+// no cap.
+func (s *SearchService) PostsIterator(ctx context.Context, query string, subreddits []string, maxCount int, opts ...SearchOptionSetter) *PostIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	it := &PostIterator{
+		service:    s,
+		query:      query,
+		subreddits: subreddits,
+		opts:       opts,
+		maxCount:   maxCount,
+		ctx:        ctx,
+		stop:       cancel,
+	}
+	it.fetchPage = it.fetchNextPage
+	return it
+}
+
+// Next advances the iterator, fetching the next page of results if the
+// current one has been exhausted. It returns false once there are no more
+// results, the context is cancelled, maxCount results have been returned, or an error
+// occurs; callers should check Err() to distinguish exhaustion from failure.
+func (it *PostIterator) Next() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.maxCount > 0 && it.count >= it.maxCount {
+		it.done = true
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.noMore {
+			it.done = true
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+		if len(it.buf) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.count++
+	return true
+}
+
+// fetch requests the next page of results and appends it to the buffer. It
+// returns false only if an error occurred; a page with an empty after cursor
+// is still appended, and marks that no further pages remain.
+func (it *PostIterator) fetch() bool {
+	posts, resp, err := it.fetchPage()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.resp = resp
+	it.buf = append(it.buf, posts...)
+	it.after = resp.After
+	it.noMore = it.after == ""
+	return true
+}
+
+// fetchNextPage requests the next page of results from the underlying
+// SearchService. It's the default value of fetchPage; tests substitute
+// fetchPage with a fake to exercise the pagination bookkeeping above
+// without a real SearchService.
+func (it *PostIterator) fetchNextPage() ([]*Post, *Response, error) {
+	opts := append([]SearchOptionSetter{SetLimit(defaultIteratorPageSize)}, it.opts...)
+	if it.after != "" {
+		opts = append(opts, SetAfter(it.after))
+	}
+	return it.service.Posts(it.ctx, it.query, it.subreddits, opts...)
+}
+
+// Post returns the post at the iterator's current position.
+func (it *PostIterator) Post() *Post {
+	return it.cur
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *PostIterator) Err() error {
+	return it.err
+}
+
+// Response returns the *Response from the most recently fetched page.
+func (it *PostIterator) Response() *Response {
+	return it.resp
+}
+
+// Close stops the iterator, cancelling any in-flight request.
+func (it *PostIterator) Close() {
+	it.stop()
+}
+
+// SubredditIterator walks the pages of a subreddit search, fetching a new
+// page via SearchService.Subreddits whenever the current one is exhausted.
+type SubredditIterator struct {
+	service  *SearchService
+	query    string
+	opts     []SearchOptionSetter
+	maxCount int
+
+	ctx    context.Context
+	stop   context.CancelFunc
+	resp   *Response
+	buf    []*Subreddit
+	cur    *Subreddit
+	after  string
+	count  int
+	noMore bool
+	done   bool
+	err    error
+}
+
+// SubredditsIterator returns a SubredditIterator over the given search.
+// maxCount caps the total number of subreddits returned across all pages; a
+// value <= 0 means no cap.
+func (s *SearchService) SubredditsIterator(ctx context.Context, query string, maxCount int, opts ...SearchOptionSetter) *SubredditIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &SubredditIterator{
+		service:  s,
+		query:    query,
+		opts:     opts,
+		maxCount: maxCount,
+		ctx:      ctx,
+		stop:     cancel,
+	}
+}
+
+// Next advances the iterator, fetching the next page of results if the
+// current one has been exhausted.
+func (it *SubredditIterator) Next() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.maxCount > 0 && it.count >= it.maxCount {
+		it.done = true
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.noMore {
+			it.done = true
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+		if len(it.buf) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.count++
+	return true
+}
+
+func (it *SubredditIterator) fetch() bool {
+	opts := append([]SearchOptionSetter{SetLimit(defaultIteratorPageSize)}, it.opts...)
+	if it.after != "" {
+		opts = append(opts, SetAfter(it.after))
+	}
+
+	subreddits, resp, err := it.service.Subreddits(it.ctx, it.query, opts...)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.resp = resp
+	it.buf = append(it.buf, subreddits...)
+	it.after = resp.After
+
+	it.noMore = it.after == ""
+	return true
+}
+
+// Subreddit returns the subreddit at the iterator's current position.
+func (it *SubredditIterator) Subreddit() *Subreddit {
+	return it.cur
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *SubredditIterator) Err() error {
+	return it.err
+}
+
+// Response returns the *Response from the most recently fetched page.
+func (it *SubredditIterator) Response() *Response {
+	return it.resp
+}
+
+// Close stops the iterator, cancelling any in-flight request.
+func (it *SubredditIterator) Close() {
+	it.stop()
+}
+
+// UserIterator walks the pages of a user search, fetching a new page via
+// SearchService.Users whenever the current one is exhausted.
+type UserIterator struct {
+	service  *SearchService
+	query    string
+	opts     []SearchOptionSetter
+	maxCount int
+
+	ctx    context.Context
+	stop   context.CancelFunc
+	resp   *Response
+	buf    []*User
+	cur    *User
+	after  string
+	count  int
+	noMore bool
+	done   bool
+	err    error
+}
+
+// UsersIterator returns a UserIterator over the given search. maxCount caps
+// the total number of users returned across all pages; a value <= 0 means no
+// cap.
+func (s *SearchService) UsersIterator(ctx context.Context, query string, maxCount int, opts ...SearchOptionSetter) *UserIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &UserIterator{
+		service:  s,
+		query:    query,
+		opts:     opts,
+		maxCount: maxCount,
+		ctx:      ctx,
+		stop:     cancel,
+	}
+}
+
+// Next advances the iterator, fetching the next page of results if the
+// current one has been exhausted.
+func (it *UserIterator) Next() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.maxCount > 0 && it.count >= it.maxCount {
+		it.done = true
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.noMore {
+			it.done = true
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+		if len(it.buf) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.count++
+	return true
+}
+
+func (it *UserIterator) fetch() bool {
+	opts := append([]SearchOptionSetter{SetLimit(defaultIteratorPageSize)}, it.opts...)
+	if it.after != "" {
+		opts = append(opts, SetAfter(it.after))
+	}
+
+	users, resp, err := it.service.Users(it.ctx, it.query, opts...)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.resp = resp
+	it.buf = append(it.buf, users...)
+	it.after = resp.After
+
+	it.noMore = it.after == ""
+	return true
+}
+
+// User returns the user at the iterator's current position.
+func (it *UserIterator) User() *User {
+	return it.cur
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// Response returns the *Response from the most recently fetched page.
+func (it *UserIterator) Response() *Response {
+	return it.resp
+}
+
+// Close stops the iterator, cancelling any in-flight request.
+func (it *UserIterator) Close() {
+	it.stop()
+}
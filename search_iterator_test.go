@@ -0,0 +1,141 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestPostIteratorStopsAfterLastPage reproduces a bug where a final page
+// that has items but an empty after cursor caused the iterator to loop
+// back to page 1 forever instead of stopping.
+func TestPostIteratorStopsAfterLastPage(t *testing.T) {
+	pages := [][]*Post{
+		{{Title: "one"}, {Title: "two"}},
+		{{Title: "three"}},
+	}
+	afters := []string{"t3_two", ""}
+
+	calls := 0
+	it := &PostIterator{ctx: context.Background(), stop: func() {}}
+	it.fetchPage = func() ([]*Post, *Response, error) {
+		if calls >= len(pages) {
+			t.Fatalf("fetch called again after the last page was already exhausted")
+		}
+		posts, after := pages[calls], afters[calls]
+		calls++
+		return posts, &Response{After: after}, nil
+	}
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Post().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"one", "two", "three"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+	if calls != len(pages) {
+		t.Fatalf("fetchPage called %d times, want %d", calls, len(pages))
+	}
+}
+
+// TestPostIteratorMaxCount verifies that Next stops once maxCount results
+// have been returned, even if more pages remain.
+func TestPostIteratorMaxCount(t *testing.T) {
+	calls := 0
+	it := &PostIterator{ctx: context.Background(), stop: func() {}, maxCount: 3}
+	it.fetchPage = func() ([]*Post, *Response, error) {
+		calls++
+		return []*Post{{Title: "a"}, {Title: "b"}}, &Response{After: "more"}, nil
+	}
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Post().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d posts, want 3 (capped by maxCount)", len(got))
+	}
+	if calls != 2 {
+		t.Fatalf("fetchPage called %d times, want 2 (one full page plus one partial)", calls)
+	}
+}
+
+// TestPostIteratorPropagatesFetchError verifies that a fetchPage error stops
+// the iterator and is surfaced via Err, without being mistaken for
+// exhaustion.
+func TestPostIteratorPropagatesFetchError(t *testing.T) {
+	boom := errors.New("boom")
+	it := &PostIterator{ctx: context.Background(), stop: func() {}}
+	it.fetchPage = func() ([]*Post, *Response, error) {
+		return nil, nil, boom
+	}
+
+	if it.Next() {
+		t.Fatal("Next() = true, want false after a fetch error")
+	}
+	if !errors.Is(it.Err(), boom) {
+		t.Fatalf("Err() = %v, want %v", it.Err(), boom)
+	}
+}
+
+// TestPostIteratorAppliesAfterCursorOnNextFetch verifies that the cursor
+// from one page's Response is recorded as the iterator's after cursor before
+// the next fetch, which is where fetchNextPage reads the after option from.
+func TestPostIteratorAppliesAfterCursorOnNextFetch(t *testing.T) {
+	var afterSeen []string
+	calls := 0
+	it := &PostIterator{ctx: context.Background(), stop: func() {}}
+	it.fetchPage = func() ([]*Post, *Response, error) {
+		afterSeen = append(afterSeen, it.after)
+		calls++
+		if calls == 1 {
+			return []*Post{{Title: "one"}}, &Response{After: "t3_cursor"}, nil
+		}
+		return []*Post{{Title: "two"}}, &Response{After: ""}, nil
+	}
+
+	for it.Next() {
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"", "t3_cursor"}
+	if len(afterSeen) != len(want) || afterSeen[0] != want[0] || afterSeen[1] != want[1] {
+		t.Fatalf("after seen by fetchPage = %v, want %v", afterSeen, want)
+	}
+}
+
+// TestPostIteratorContextCancellation verifies that Next stops and surfaces
+// the context error once the iterator's context is cancelled.
+func TestPostIteratorContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := &PostIterator{ctx: ctx, stop: cancel}
+	it.fetchPage = func() ([]*Post, *Response, error) {
+		t.Fatal("fetchPage called after the context was cancelled")
+		return nil, nil, nil
+	}
+
+	it.Close()
+
+	if it.Next() {
+		t.Fatal("Next() = true, want false once the context is cancelled")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("Err() = %v, want context.Canceled", it.Err())
+	}
+}
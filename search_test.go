@@ -0,0 +1,73 @@
+package reddit
+
+import (
+	"net/url"
+	"testing"
+)
+
+// TestSetQueryScoping verifies that an empty literal query still sends
+// q="" for ordinary callers that search with an empty query, while a
+// query already set by SetQueryBuilder is left untouched.
+func TestSetQueryScoping(t *testing.T) {
+	t.Run("empty query with no builder sends q=", func(t *testing.T) {
+		opts := make(url.Values)
+		setQuery("")(opts)
+		if got, ok := opts["q"]; !ok || got[0] != "" {
+			t.Fatalf("q = %v, want present and empty", got)
+		}
+	})
+
+	t.Run("empty query does not clobber a query builder's q", func(t *testing.T) {
+		opts := make(url.Values)
+		SetQueryBuilder(Query().Subreddit("golang"))(opts)
+		setQuery("")(opts)
+		if got := opts.Get("q"); got != "subreddit:golang" {
+			t.Fatalf("q = %q, want %q", got, "subreddit:golang")
+		}
+	})
+
+	t.Run("non-empty query always wins", func(t *testing.T) {
+		opts := make(url.Values)
+		SetQueryBuilder(Query().Subreddit("golang"))(opts)
+		setQuery("rust")(opts)
+		if got := opts.Get("q"); got != "rust" {
+			t.Fatalf("q = %q, want %q", got, "rust")
+		}
+	})
+}
+
+// TestPopulateResponsePagination verifies the After/Before/Count copying
+// that populatePagination delegates to, including that nil fields (absent
+// from the listing envelope) are left untouched on resp.
+func TestPopulateResponsePagination(t *testing.T) {
+	after, before, count := "t3_after", "t3_before", 25
+
+	t.Run("all fields present", func(t *testing.T) {
+		resp := &Response{}
+		populateResponsePagination(resp, &after, &before, &count)
+		if resp.After != after || resp.Before != before || resp.Count != count {
+			t.Fatalf("got %+v, want After=%q Before=%q Count=%d", resp, after, before, count)
+		}
+	})
+
+	t.Run("nil fields are left untouched", func(t *testing.T) {
+		resp := &Response{After: "existing"}
+		populateResponsePagination(resp, nil, nil, nil)
+		if resp.After != "existing" || resp.Before != "" || resp.Count != 0 {
+			t.Fatalf("got %+v, want After unchanged and Before/Count zero", resp)
+		}
+	})
+}
+
+// TestSearchTypesOverride verifies that a caller-supplied SearchTypes wins
+// over the default "link,sr,user" that SearchService.All prepends, since
+// SearchTypes is appended after the default and url.Values.Set replaces
+// rather than appends.
+func TestSearchTypesOverride(t *testing.T) {
+	opts := make(url.Values)
+	SearchTypes("link", "sr", "user")(opts)
+	SearchTypes("link")(opts)
+	if got := opts.Get("type"); got != "link" {
+		t.Fatalf("type = %q, want %q", got, "link")
+	}
+}
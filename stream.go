@@ -0,0 +1,312 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// streamSeenCap is the number of recently-seen fullnames the stream
+// subsystem keeps around to deduplicate results between polls.
+const streamSeenCap = 300
+
+// streamMinBackoff and streamMaxBackoff bound the exponential backoff used
+// when a poll fails with a rate limit or server error.
+const (
+	streamMinBackoff = time.Second
+	streamMaxBackoff = 2 * time.Minute
+)
+
+// StreamService handles communication with the parts of the Reddit API
+// that this package polls on an interval to build live streams of new
+// posts and comments, modeled on PRAW's stream_generator.
+type StreamService struct {
+	client *Client
+}
+
+// StreamOpts configures a stream started via StreamService.
+type StreamOpts struct {
+	// Interval is how often the stream polls for new results. Defaults to
+	// 5 seconds if zero.
+	Interval time.Duration
+	// SkipExisting marks everything present on the first poll as already
+	// seen, so only results that show up afterwards are emitted.
+	SkipExisting bool
+}
+
+// seenBuffer is a fixed-capacity ring buffer of recently-seen fullnames,
+// used to deduplicate stream results across polls.
+type seenBuffer struct {
+	cap   int
+	ids   []string
+	index map[string]struct{}
+}
+
+func newSeenBuffer(capacity int) *seenBuffer {
+	return &seenBuffer{
+		cap:   capacity,
+		index: make(map[string]struct{}, capacity),
+	}
+}
+
+// addIfNew records id and reports whether it hadn't been seen before.
+func (b *seenBuffer) addIfNew(id string) bool {
+	if _, ok := b.index[id]; ok {
+		return false
+	}
+
+	if len(b.ids) >= b.cap {
+		oldest := b.ids[0]
+		b.ids = b.ids[1:]
+		delete(b.index, oldest)
+	}
+
+	b.ids = append(b.ids, id)
+	b.index[id] = struct{}{}
+	return true
+}
+
+// retryableStreamError wraps an error from a failed poll to mark it as
+// transient (a rate limit or server error), so run backs off and retries
+// instead of treating it as fatal.
+type retryableStreamError struct {
+	err error
+}
+
+func (e *retryableStreamError) Error() string { return e.err.Error() }
+func (e *retryableStreamError) Unwrap() error { return e.err }
+
+// isRetryableStreamErr reports whether err was marked retryable by
+// streamFetchErr.
+func isRetryableStreamErr(err error) bool {
+	var re *retryableStreamError
+	return errors.As(err, &re)
+}
+
+// streamFetchErr wraps err as retryable if resp indicates a rate limit or
+// server error, so run backs off instead of giving up on transient failures.
+// Errors from any other status, or with no response at all (e.g. a request
+// that was never sent), are left as fatal.
+func streamFetchErr(resp *Response, err error) error {
+	if err == nil {
+		return nil
+	}
+	if resp != nil && resp.Response != nil {
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return &retryableStreamError{err: err}
+		}
+	}
+	return err
+}
+
+// SearchPosts polls query on an interval and delivers newly-matching posts
+// over the returned channel. It stops and closes both channels when the
+// context is cancelled or the returned stop function is called.
+func (s *StreamService) SearchPosts(ctx context.Context, query string, subreddits []string, opts StreamOpts) (<-chan *Post, <-chan error, func()) {
+	fetch := func(ctx context.Context) ([]*Post, error) {
+		posts, resp, err := s.client.Search.Posts(ctx, query, subreddits, sortSetter(SortNew))
+		if err != nil {
+			return nil, streamFetchErr(resp, err)
+		}
+		return posts, nil
+	}
+
+	return s.streamPosts(ctx, fetch, opts)
+}
+
+// NewPostsInSubreddit polls r/subreddit on an interval and delivers newly
+// submitted posts over the returned channel. It stops and closes both
+// channels when the context is cancelled or the returned stop function is
+// called.
+func (s *StreamService) NewPostsInSubreddit(ctx context.Context, subreddit string, opts StreamOpts) (<-chan *Post, <-chan error, func()) {
+	fetch := func(ctx context.Context) ([]*Post, error) {
+		posts, resp, err := s.client.Subreddit.NewPosts(ctx, subreddit)
+		if err != nil {
+			return nil, streamFetchErr(resp, err)
+		}
+		return posts, nil
+	}
+
+	return s.streamPosts(ctx, fetch, opts)
+}
+
+// NewComments polls r/subreddit on an interval and delivers newly posted
+// comments over the returned channel. It stops and closes both channels
+// when the context is cancelled or the returned stop function is called.
+func (s *StreamService) NewComments(ctx context.Context, subreddit string, opts StreamOpts) (<-chan *Comment, <-chan error, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	comments := make(chan *Comment)
+	errs := make(chan error, 1)
+	seen := newSeenBuffer(streamSeenCap)
+
+	fetch := func(ctx context.Context) ([]*Comment, error) {
+		fetched, resp, err := s.client.Subreddit.Comments(ctx, subreddit, SetSort("new"))
+		if err != nil {
+			return nil, streamFetchErr(resp, err)
+		}
+		return fetched, nil
+	}
+
+	go func() {
+		defer close(comments)
+		defer close(errs)
+
+		prime := func(ctx context.Context) error {
+			fetched, err := fetch(ctx)
+			if err != nil {
+				return err
+			}
+			for _, comment := range fetched {
+				seen.addIfNew(comment.FullID)
+			}
+			return nil
+		}
+
+		poll := func(ctx context.Context) error {
+			fetched, err := fetch(ctx)
+			if err != nil {
+				return err
+			}
+
+			for i := len(fetched) - 1; i >= 0; i-- {
+				comment := fetched[i]
+				if !seen.addIfNew(comment.FullID) {
+					continue
+				}
+				select {
+				case comments <- comment:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		}
+
+		s.run(ctx, opts, prime, poll, errs)
+	}()
+
+	return comments, errs, cancel
+}
+
+// streamPosts runs the poll loop shared by SearchPosts and
+// NewPostsInSubreddit against the given fetch function.
+func (s *StreamService) streamPosts(ctx context.Context, fetch func(context.Context) ([]*Post, error), opts StreamOpts) (<-chan *Post, <-chan error, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	posts := make(chan *Post)
+	errs := make(chan error, 1)
+	seen := newSeenBuffer(streamSeenCap)
+
+	go func() {
+		defer close(posts)
+		defer close(errs)
+
+		prime := func(ctx context.Context) error {
+			fetched, err := fetch(ctx)
+			if err != nil {
+				return err
+			}
+			for _, post := range fetched {
+				seen.addIfNew(post.FullID)
+			}
+			return nil
+		}
+
+		poll := func(ctx context.Context) error {
+			fetched, err := fetch(ctx)
+			if err != nil {
+				return err
+			}
+
+			for i := len(fetched) - 1; i >= 0; i-- {
+				post := fetched[i]
+				if !seen.addIfNew(post.FullID) {
+					continue
+				}
+				select {
+				case posts <- post:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		}
+
+		s.run(ctx, opts, prime, poll, errs)
+	}()
+
+	return posts, errs, cancel
+}
+
+// run drives the shared poll/backoff/SkipExisting loop, invoking poll once
+// per interval until ctx is done. If opts.SkipExisting is set, prime runs
+// once up front to record everything already present as seen, without
+// emitting it. Errors from prime or poll are reported on errs unless ctx is
+// already done or errs is full (see reportStreamErr); retryable errors (429/5xx, per streamFetchErr) trigger exponential backoff,
+// while any other error is treated as fatal and stops the loop.
+func (s *StreamService) run(ctx context.Context, opts StreamOpts, prime, poll func(context.Context) error, errs chan<- error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	if opts.SkipExisting {
+		if err := prime(ctx); err != nil && ctx.Err() == nil {
+			reportStreamErr(ctx, errs, err)
+			if !isRetryableStreamErr(err) {
+				return
+			}
+		}
+	}
+
+	backoff := time.Duration(0)
+	for {
+		wait := interval
+		if backoff > 0 {
+			wait = backoff
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			reportStreamErr(ctx, errs, err)
+
+			if !isRetryableStreamErr(err) {
+				return
+			}
+
+			if backoff == 0 {
+				backoff = streamMinBackoff
+			} else {
+				backoff *= 2
+			}
+			if backoff > streamMaxBackoff {
+				backoff = streamMaxBackoff
+			}
+			continue
+		}
+
+		backoff = 0
+	}
+}
+
+// reportStreamErr delivers err on errs without blocking the poll loop if
+// the caller isn't currently reading from it.
+func reportStreamErr(ctx context.Context, errs chan<- error, err error) {
+	select {
+	case errs <- err:
+	case <-ctx.Done():
+	default:
+	}
+}
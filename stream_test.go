@@ -0,0 +1,120 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestStreamPostsSkipExistingDoesNotEmitFirstPage reproduces a bug where
+// StreamOpts.SkipExisting delivered the posts already present on the first
+// poll instead of silently recording them as seen.
+func TestStreamPostsSkipExistingDoesNotEmitFirstPage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	existing := []*Post{{FullID: "t3_1"}, {FullID: "t3_2"}}
+	fresh := &Post{FullID: "t3_3"}
+
+	calls := 0
+	fetch := func(context.Context) ([]*Post, error) {
+		calls++
+		if calls == 1 {
+			return existing, nil
+		}
+		return []*Post{fresh}, nil
+	}
+
+	s := &StreamService{}
+	posts, errs, stop := s.streamPosts(ctx, fetch, StreamOpts{
+		Interval:     time.Millisecond,
+		SkipExisting: true,
+	})
+	defer stop()
+
+	select {
+	case post := <-posts:
+		if post.FullID != fresh.FullID {
+			t.Fatalf("got post %q, want only the post fetched after priming (%q)", post.FullID, fresh.FullID)
+		}
+	case err := <-errs:
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the post fetched after priming")
+	}
+}
+
+// TestStreamFetchErrRetryability verifies streamFetchErr only marks 429s and
+// 5xxs as retryable, leaving every other error (and responses with no
+// underlying *http.Response at all) fatal.
+func TestStreamFetchErrRetryability(t *testing.T) {
+	boom := errors.New("boom")
+
+	tests := []struct {
+		name string
+		resp *Response
+		want bool
+	}{
+		{"no response", nil, false},
+		{"response with no http.Response", &Response{}, false},
+		{"429", &Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}, true},
+		{"500", &Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, true},
+		{"404", &Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := streamFetchErr(tt.resp, boom)
+			if got := isRetryableStreamErr(err); got != tt.want {
+				t.Fatalf("isRetryableStreamErr() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, boom) {
+				t.Fatalf("streamFetchErr() lost the underlying error: %v", err)
+			}
+		})
+	}
+}
+
+// TestStreamRunStopsOnFatalPollError reproduces a bug where run backed off
+// and retried forever on any poll error, including ones that streamFetchErr
+// leaves fatal (e.g. a 404), instead of giving up.
+func TestStreamRunStopsOnFatalPollError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fatal := streamFetchErr(&Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("not found"))
+
+	polls := 0
+	poll := func(context.Context) error {
+		polls++
+		return fatal
+	}
+
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		(&StreamService{}).run(ctx, StreamOpts{Interval: time.Millisecond}, nil, poll, errs)
+		close(done)
+	}()
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, fatal) {
+			t.Fatalf("got error %v, want %v", err, fatal)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the fatal error to be reported")
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run kept looping after a fatal poll error instead of returning")
+	}
+
+	if polls != 1 {
+		t.Fatalf("poll called %d times, want 1 (no retry after a fatal error)", polls)
+	}
+}